@@ -0,0 +1,138 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tunabay/go-tdma"
+)
+
+func TestMatrix_SolveBatch(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	rs := [][]float64{
+		{4, 8, 12, 11},
+		{2, 4, 6, 5.5},
+	}
+	want := [][]float64{
+		{1, 2, 3, 4},
+		{0.5, 1, 1.5, 2},
+	}
+	xs, err := m.SolveBatch(rs)
+	if err != nil {
+		t.Fatalf("SolveBatch failed: %v", err)
+	}
+	for i, x := range xs {
+		for j, we := range want[i] {
+			if 1e-6 < math.Abs(x[j]-we) {
+				t.Errorf("rhs %d: got %+v, want %+v", i, x, want[i])
+				break
+			}
+		}
+	}
+}
+
+func TestMatrix_SolvePCR(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 7, 16, 37} {
+		dl := make([]float64, n-1)
+		d := make([]float64, n)
+		du := make([]float64, n-1)
+		r := make([]float64, n)
+		for i := range d {
+			d[i] = 4
+			r[i] = float64(i + 1)
+		}
+		for i := range dl {
+			dl[i], du[i] = 1, 1
+		}
+		m, err := tdma.NewFromDiagonals(dl, d, du)
+		if err != nil {
+			t.Fatalf("n=%d: NewFromDiagonals failed: %v", n, err)
+		}
+		x, err := m.SolvePCR(r)
+		if err != nil {
+			t.Fatalf("n=%d: SolvePCR failed: %v", n, err)
+		}
+		got, err := m.MulVec(x)
+		if err != nil {
+			t.Fatalf("n=%d: MulVec failed: %v", n, err)
+		}
+		for i, ge := range got {
+			if 1e-6 < math.Abs(ge-r[i]) {
+				t.Errorf("n=%d: residual at %d: A*x = %+v, want %+v", n, i, got, r)
+				break
+			}
+		}
+	}
+}
+
+// TestMatrix_SolveAuto_largeN exercises SolveAuto at a system size at or
+// above pcrMinSize, where on multi-core machines it routes through
+// SolvePCR rather than Solve. The test checks the residual A*x against r,
+// which must hold whichever path is taken, so it makes no assumption about
+// runtime.GOMAXPROCS.
+func TestMatrix_SolveAuto_largeN(t *testing.T) {
+	const n = 1024
+	dl := make([]float64, n-1)
+	d := make([]float64, n)
+	du := make([]float64, n-1)
+	r := make([]float64, n)
+	for i := range d {
+		d[i] = 4
+		r[i] = float64(i + 1)
+	}
+	for i := range dl {
+		dl[i], du[i] = 1, 1
+	}
+	m, err := tdma.NewFromDiagonals(dl, d, du)
+	if err != nil {
+		t.Fatalf("NewFromDiagonals failed: %v", err)
+	}
+	x, err := m.SolveAuto(r)
+	if err != nil {
+		t.Fatalf("SolveAuto failed: %v", err)
+	}
+	got, err := m.MulVec(x)
+	if err != nil {
+		t.Fatalf("MulVec failed: %v", err)
+	}
+	for i, ge := range got {
+		if 1e-6 < math.Abs(ge-r[i]) {
+			t.Fatalf("residual at %d: A*x = %+v, want %+v", i, got, r)
+		}
+	}
+}
+
+func TestMatrix_SolveAuto(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	x, err := m.SolveAuto([]float64{4, 8, 12, 11})
+	if err != nil {
+		t.Fatalf("SolveAuto failed: %v", err)
+	}
+	want := []float64{1, 2, 3, 4}
+	for i, we := range want {
+		if 1e-6 < math.Abs(x[i]-we) {
+			t.Errorf("got %+v, want %+v", x, want)
+			break
+		}
+	}
+}
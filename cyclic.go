@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma
+
+import "fmt"
+
+// CyclicMatrix represents a tridiagonal matrix with periodic boundary
+// conditions: in addition to the three diagonals, it has two corner entries
+// alpha at (0, n-1) and beta at (n-1, 0). This shape arises from periodic
+// boundary conditions in PDE discretizations.
+type CyclicMatrix struct {
+	m           *Matrix
+	alpha, beta float64
+}
+
+// NewCyclicMatrix creates a new n x n cyclic tridiagonal matrix from the
+// three diagonals dl (length n-1), d (length n) and du (length n-1), plus
+// the corner entries alpha (row 0, column n-1) and beta (row n-1, column 0).
+// n must be at least 3, since for smaller n the corners overlap the
+// diagonals.
+func NewCyclicMatrix(dl, d, du []float64, alpha, beta float64) (*CyclicMatrix, error) {
+	if len(d) < 3 {
+		return nil, fmt.Errorf("%w: d must have at least 3 elements",
+			ErrInvalidTridiagonalMatrix)
+	}
+	m, err := NewFromDiagonals(dl, d, du)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CyclicMatrix{m: m, alpha: alpha, beta: beta}, nil
+}
+
+// Solve solves the system of equations M * x = r for the cyclic tridiagonal
+// matrix M, using the Sherman-Morrison formula to reduce it to two solves of
+// a plain (non-cyclic) tridiagonal system sharing one LU factorization.
+// https://en.wikipedia.org/wiki/Sherman%E2%80%93Morrison_formula
+func (c *CyclicMatrix) Solve(r []float64) ([]float64, error) {
+	n := c.m.n
+	if len(r) != n {
+		return nil, fmt.Errorf("%w: r must have exactly %d elements",
+			ErrDimensionMismatch, n)
+	}
+
+	gamma := -c.m.d[0]
+	if gamma == 0 {
+		gamma = 1
+	}
+	dl := append([]float64(nil), c.m.dl...)
+	d := append([]float64(nil), c.m.d...)
+	du := append([]float64(nil), c.m.du...)
+	d[0] -= gamma
+	d[n-1] -= c.alpha * c.beta / gamma
+	am := &Matrix{n: n, dl: dl, d: d, du: du}
+
+	lu, err := am.Factorize()
+	if err != nil {
+		return nil, err
+	}
+	y, err := lu.Solve(r)
+	if err != nil {
+		return nil, err
+	}
+	u := make([]float64, n)
+	u[0], u[n-1] = gamma, c.beta
+	z, err := lu.Solve(u)
+	if err != nil {
+		return nil, err
+	}
+
+	// v = (1, 0, ..., 0, alpha/gamma)
+	vy := y[0] + c.alpha/gamma*y[n-1]
+	vz := z[0] + c.alpha/gamma*z[n-1]
+	factor := vy / (1 + vz)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = y[i] - factor*z[i]
+	}
+
+	return x, nil
+}
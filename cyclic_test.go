@@ -0,0 +1,87 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tunabay/go-tdma"
+)
+
+// cyclicMulVec computes A*x for a cyclic tridiagonal matrix defined by its
+// diagonals and corners, used here only to check the residual of Solve.
+func cyclicMulVec(dl, d, du []float64, alpha, beta float64, x []float64) []float64 {
+	n := len(d)
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		v := d[i] * x[i]
+		if 0 < i {
+			v += dl[i-1] * x[i-1]
+		}
+		if i < n-1 {
+			v += du[i] * x[i+1]
+		}
+		y[i] = v
+	}
+	y[0] += alpha * x[n-1]
+	y[n-1] += beta * x[0]
+
+	return y
+}
+
+func TestCyclicMatrix_Solve(t *testing.T) {
+	dl := []float64{1, 1, 1, 1}
+	d := []float64{4, 4, 4, 4, 4}
+	du := []float64{1, 1, 1, 1}
+	alpha, beta := 1.0, 1.0
+	r := []float64{1, 2, 3, 4, 5}
+
+	c, err := tdma.NewCyclicMatrix(dl, d, du, alpha, beta)
+	if err != nil {
+		t.Fatalf("NewCyclicMatrix failed: %v", err)
+	}
+	x, err := c.Solve(r)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	got := cyclicMulVec(dl, d, du, alpha, beta, x)
+	for i, ge := range got {
+		if 1e-6 < math.Abs(ge-r[i]) {
+			t.Errorf("residual at %d: A*x = %+v, want %+v", i, got, r)
+			break
+		}
+	}
+}
+
+func TestCyclicMatrix_Solve_asymmetricCorners(t *testing.T) {
+	dl := []float64{1, 1, 1, 1}
+	d := []float64{4, 4, 4, 4, 4}
+	du := []float64{1, 1, 1, 1}
+	alpha, beta := 2.0, 5.0
+	r := []float64{1, 2, 3, 4, 5}
+
+	c, err := tdma.NewCyclicMatrix(dl, d, du, alpha, beta)
+	if err != nil {
+		t.Fatalf("NewCyclicMatrix failed: %v", err)
+	}
+	x, err := c.Solve(r)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	got := cyclicMulVec(dl, d, du, alpha, beta, x)
+	for i, ge := range got {
+		if 1e-6 < math.Abs(ge-r[i]) {
+			t.Errorf("residual at %d: A*x = %+v, want %+v", i, got, r)
+			break
+		}
+	}
+}
+
+func TestNewCyclicMatrix_tooSmall(t *testing.T) {
+	if _, err := tdma.NewCyclicMatrix([]float64{1}, []float64{1, 1}, []float64{1}, 1, 1); err == nil {
+		t.Error("expected error for n < 3, got nil")
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma
+
+import "fmt"
+
+// LU is a reusable LU factorization of a tridiagonal matrix, computed with
+// partial pivoting. A single LU can be solved against many right-hand sides
+// without redoing the O(n) forward sweep each time, which is useful when the
+// same matrix is solved repeatedly, e.g. in implicit PDE time-stepping.
+type LU struct {
+	n         int
+	dl, d, du []float64 // multipliers and the upper-triangular factor
+	du2       []float64 // fill-in second super-diagonal from pivoting
+	ipiv      []int     // ipiv[i] == i+1 means rows i, i+1 were swapped
+	nswap     int       // number of row interchanges, for the sign of det
+}
+
+// Factorize computes the LU factorization of m with partial pivoting, and
+// returns it as a reusable *LU.
+func (m *Matrix) Factorize() (*LU, error) {
+	dl, d, du := m.diagonals()
+	du2, ipiv, err := factorizePivot(dl, d, du)
+	if err != nil {
+		return nil, err
+	}
+	nswap := 0
+	for i, p := range ipiv {
+		if p != i {
+			nswap++
+		}
+	}
+
+	return &LU{n: m.n, dl: dl, d: d, du: du, du2: du2, ipiv: ipiv, nswap: nswap}, nil
+}
+
+// Solve solves L*U*x = r against the precomputed factorization and returns
+// the x solved. r must have exactly n elements and is not modified.
+func (lu *LU) Solve(r []float64) ([]float64, error) {
+	if len(r) != lu.n {
+		return nil, fmt.Errorf("%w: r must have exactly %d elements",
+			ErrDimensionMismatch, lu.n)
+	}
+	rr := append([]float64(nil), r...)
+	applyPivot(lu.dl, lu.ipiv, rr)
+
+	return backSubstitutePivot(lu.d, lu.du, lu.du2, rr), nil
+}
+
+// SolveInPlace solves L*U*x = r against the precomputed factorization,
+// overwriting r with the solution x. r must have exactly n elements.
+func (lu *LU) SolveInPlace(r []float64) error {
+	if len(r) != lu.n {
+		return fmt.Errorf("%w: r must have exactly %d elements",
+			ErrDimensionMismatch, lu.n)
+	}
+	applyPivot(lu.dl, lu.ipiv, r)
+	copy(r, backSubstitutePivot(lu.d, lu.du, lu.du2, r))
+
+	return nil
+}
+
+// Determinant calculates the determinant of the factorized matrix in O(n)
+// from the diagonal of U, flipping the sign for each row interchange
+// recorded during pivoting.
+func (lu *LU) Determinant() float64 {
+	det := 1.0
+	for _, v := range lu.d {
+		det *= v
+	}
+	if lu.nswap%2 != 0 {
+		det = -det
+	}
+
+	return det
+}
+
+// Determinant calculates the determinant of the tridiagonal matrix.
+func (m *Matrix) Determinant() float64 {
+	lu, err := m.Factorize()
+	if err != nil {
+		return 0
+	}
+
+	return lu.Determinant()
+}
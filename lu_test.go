@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tunabay/go-tdma"
+)
+
+func TestMatrix_Factorize(t *testing.T) {
+	equal := func(x, y []float64) bool {
+		const eps = 1e-3
+		if len(x) != len(y) {
+			return false
+		}
+		for i, xe := range x {
+			if eps < math.Abs(y[i]-xe) {
+				return false
+			}
+		}
+		return true
+	}
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	lu, err := m.Factorize()
+	if err != nil {
+		t.Fatalf("Factorize failed: %v", err)
+	}
+	for _, tc := range []struct {
+		r, want []float64
+	}{
+		{[]float64{4, 8, 12, 11}, []float64{1, 2, 3, 4}},
+		{[]float64{2, 4, 6, 5.5}, []float64{0.5, 1, 1.5, 2}},
+	} {
+		x, err := lu.Solve(tc.r)
+		if err != nil {
+			t.Errorf("Solve failed: %v", err)
+			continue
+		}
+		if !equal(x, tc.want) {
+			t.Errorf("got %+v, want %+v", x, tc.want)
+		}
+	}
+}
+
+func TestMatrix_Factorize_SolveInPlace(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	lu, err := m.Factorize()
+	if err != nil {
+		t.Fatalf("Factorize failed: %v", err)
+	}
+	r := []float64{4, 8, 12, 11}
+	if err := lu.SolveInPlace(r); err != nil {
+		t.Fatalf("SolveInPlace failed: %v", err)
+	}
+	want := []float64{1, 2, 3, 4}
+	for i, xe := range want {
+		if 1e-3 < math.Abs(r[i]-xe) {
+			t.Errorf("got %+v, want %+v", r, want)
+			break
+		}
+	}
+}
+
+func TestMatrix_Determinant(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	const want = 5.0 // det of this 4x4 tridiagonal matrix
+	if got := m.Determinant(); 1e-6 < math.Abs(got-want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma
+
+import "fmt"
+
+// NewFromDiagonals creates a new n x n tridiagonal matrix directly from its
+// three diagonals: sub-diagonal dl (length n-1), main diagonal d (length n)
+// and super-diagonal du (length n-1). The matrix keeps the slices as given,
+// without copying, so the caller can mutate the coefficients in place
+// between solves without rebuilding the matrix.
+func NewFromDiagonals(dl, d, du []float64) (*Matrix, error) {
+	n := len(d)
+	if n < 1 {
+		return nil, fmt.Errorf("%w: d must have at least 1 element",
+			ErrInvalidTridiagonalMatrix)
+	}
+	if len(dl) != n-1 {
+		return nil, fmt.Errorf("%w: dl must have exactly %d elements",
+			ErrInvalidTridiagonalMatrix, n-1)
+	}
+	if len(du) != n-1 {
+		return nil, fmt.Errorf("%w: du must have exactly %d elements",
+			ErrInvalidTridiagonalMatrix, n-1)
+	}
+
+	return &Matrix{n: n, dl: dl, d: d, du: du}, nil
+}
+
+// Diagonals returns the sub-diagonal, main diagonal and super-diagonal of m.
+// The returned slices are the matrix's own backing arrays, not copies, so
+// modifying them mutates m.
+func (m *Matrix) Diagonals() (dl, d, du []float64) {
+	return m.dl, m.d, m.du
+}
+
+// N returns the size n of the n x n matrix.
+func (m *Matrix) N() int { return m.n }
+
+// At returns the element at row i, column j. i and j must be in [0, n). At
+// returns 0 for any (i, j) outside the tridiagonal band.
+func (m *Matrix) At(i, j int) float64 {
+	m.checkIndex(i, j)
+	switch j - i {
+	case 0:
+		return m.d[i]
+	case -1:
+		return m.dl[j]
+	case 1:
+		return m.du[i]
+	default:
+		return 0
+	}
+}
+
+// SetBand sets the element at row i, column j to v. i and j must be in
+// [0, n); SetBand panics otherwise. (i, j) must be within the tridiagonal
+// band, i.e. |i-j| <= 1; otherwise SetBand returns ErrInvalidTridiagonalMatrix.
+func (m *Matrix) SetBand(i, j int, v float64) error {
+	m.checkIndex(i, j)
+	switch j - i {
+	case 0:
+		m.d[i] = v
+	case -1:
+		m.dl[j] = v
+	case 1:
+		m.du[i] = v
+	default:
+		return fmt.Errorf("%w: (%d, %d) is outside the tridiagonal band",
+			ErrInvalidTridiagonalMatrix, i, j)
+	}
+
+	return nil
+}
+
+func (m *Matrix) checkIndex(i, j int) {
+	if i < 0 || m.n <= i || j < 0 || m.n <= j {
+		panic(fmt.Sprintf("tdma: index (%d, %d) out of range for %dx%d matrix",
+			i, j, m.n, m.n))
+	}
+}
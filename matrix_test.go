@@ -0,0 +1,101 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tunabay/go-tdma"
+)
+
+func TestNewFromDiagonals(t *testing.T) {
+	dl := []float64{1, 1, 1}
+	d := []float64{2, 2, 2, 2}
+	du := []float64{1, 1, 1}
+	m, err := tdma.NewFromDiagonals(dl, d, du)
+	if err != nil {
+		t.Fatalf("NewFromDiagonals failed: %v", err)
+	}
+	x, err := m.TDMA([]float64{4, 8, 12, 11})
+	if err != nil {
+		t.Fatalf("TDMA failed: %v", err)
+	}
+	want := []float64{1, 2, 3, 4}
+	for i, xe := range want {
+		if 1e-3 < abs(x[i]-xe) {
+			t.Errorf("got %+v, want %+v", x, want)
+			break
+		}
+	}
+
+	// mutating the caller's slices must be reflected in the matrix.
+	d[0] = 3
+	if got := m.At(0, 0); got != 3 {
+		t.Errorf("At(0,0) = %v, want 3", got)
+	}
+}
+
+func TestNewFromDiagonals_invalid(t *testing.T) {
+	if _, err := tdma.NewFromDiagonals([]float64{1, 1}, []float64{2, 2, 2}, []float64{1}); !errors.Is(err, tdma.ErrInvalidTridiagonalMatrix) {
+		t.Errorf("got %v, want ErrInvalidTridiagonalMatrix", err)
+	}
+	if _, err := tdma.NewFromDiagonals(nil, nil, nil); !errors.Is(err, tdma.ErrInvalidTridiagonalMatrix) {
+		t.Errorf("got %v, want ErrInvalidTridiagonalMatrix", err)
+	}
+}
+
+func TestMatrix_At(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	for _, tc := range []struct {
+		i, j int
+		want float64
+	}{
+		{0, 0, 2}, {0, 1, 1}, {0, 2, 0},
+		{1, 0, 1}, {1, 1, 2}, {1, 2, 1},
+		{3, 3, 2}, {3, 2, 1}, {3, 1, 0},
+	} {
+		if got := m.At(tc.i, tc.j); got != tc.want {
+			t.Errorf("At(%d,%d) = %v, want %v", tc.i, tc.j, got, tc.want)
+		}
+	}
+}
+
+func TestMatrix_SetBand(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	if err := m.SetBand(1, 1, 5); err != nil {
+		t.Fatalf("SetBand failed: %v", err)
+	}
+	if got := m.At(1, 1); got != 5 {
+		t.Errorf("At(1,1) = %v, want 5", got)
+	}
+	if err := m.SetBand(0, 3, 9); !errors.Is(err, tdma.ErrInvalidTridiagonalMatrix) {
+		t.Errorf("got %v, want ErrInvalidTridiagonalMatrix", err)
+	}
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma
+
+import (
+	"fmt"
+	"math"
+)
+
+// MulVec computes A*x for the tridiagonal matrix A and the vector x, and
+// returns the result. x must have exactly n elements for the n x n matrix.
+func (m *Matrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != m.n {
+		return nil, fmt.Errorf("%w: x must have exactly %d elements",
+			ErrDimensionMismatch, m.n)
+	}
+	y := make([]float64, m.n)
+	for i := 0; i < m.n; i++ {
+		v := m.d[i] * x[i]
+		if 0 < i {
+			v += m.dl[i-1] * x[i-1]
+		}
+		if i < m.n-1 {
+			v += m.du[i] * x[i+1]
+		}
+		y[i] = v
+	}
+
+	return y, nil
+}
+
+// MulVecTrans computes A^T*x for the tridiagonal matrix A and the vector x,
+// and returns the result. x must have exactly n elements for the n x n
+// matrix.
+func (m *Matrix) MulVecTrans(x []float64) ([]float64, error) {
+	if len(x) != m.n {
+		return nil, fmt.Errorf("%w: x must have exactly %d elements",
+			ErrDimensionMismatch, m.n)
+	}
+	y := make([]float64, m.n)
+	for i := 0; i < m.n; i++ {
+		v := m.d[i] * x[i]
+		if 0 < i {
+			v += m.du[i-1] * x[i-1]
+		}
+		if i < m.n-1 {
+			v += m.dl[i] * x[i+1]
+		}
+		y[i] = v
+	}
+
+	return y, nil
+}
+
+// NormKind identifies a matrix norm, following the conventions used by
+// LAPACK's *lange routines.
+type NormKind int
+
+const (
+	// MaxAbs is the largest absolute value of any element, max |a_ij|.
+	// This is not a proper matrix norm, but is cheap and commonly used.
+	MaxAbs NormKind = iota
+
+	// One is the 1-norm, the maximum absolute column sum.
+	One
+
+	// Inf is the infinity-norm, the maximum absolute row sum.
+	Inf
+
+	// Frobenius is the Frobenius norm, the square root of the sum of the
+	// squares of the elements.
+	Frobenius
+)
+
+// Norm computes the matrix norm of the given kind.
+func (m *Matrix) Norm(kind NormKind) float64 {
+	switch kind {
+	case MaxAbs:
+		return m.normMaxAbs()
+	case One:
+		return m.normOne()
+	case Inf:
+		return m.normInf()
+	case Frobenius:
+		return m.normFrobenius()
+	default:
+		panic(fmt.Sprintf("tdma: unknown NormKind %d", kind))
+	}
+}
+
+func (m *Matrix) normMaxAbs() float64 {
+	max := 0.0
+	for _, v := range m.d {
+		max = math.Max(max, math.Abs(v))
+	}
+	for _, v := range m.dl {
+		max = math.Max(max, math.Abs(v))
+	}
+	for _, v := range m.du {
+		max = math.Max(max, math.Abs(v))
+	}
+
+	return max
+}
+
+// normOne is the maximum absolute column sum. Column j holds du[j-1] (row
+// j-1), d[j] and dl[j] (row j+1).
+func (m *Matrix) normOne() float64 {
+	max := 0.0
+	for j := 0; j < m.n; j++ {
+		sum := math.Abs(m.d[j])
+		if 0 < j {
+			sum += math.Abs(m.du[j-1])
+		}
+		if j < m.n-1 {
+			sum += math.Abs(m.dl[j])
+		}
+		max = math.Max(max, sum)
+	}
+
+	return max
+}
+
+// normInf is the maximum absolute row sum. Row i holds dl[i-1] (col i-1),
+// d[i] and du[i] (col i+1).
+func (m *Matrix) normInf() float64 {
+	max := 0.0
+	for i := 0; i < m.n; i++ {
+		sum := math.Abs(m.d[i])
+		if 0 < i {
+			sum += math.Abs(m.dl[i-1])
+		}
+		if i < m.n-1 {
+			sum += math.Abs(m.du[i])
+		}
+		max = math.Max(max, sum)
+	}
+
+	return max
+}
+
+// normFrobenius computes sqrt(sum(a_ij^2)) using a scaled running sum, in the
+// style of LAPACK's DLASSQ, to avoid overflow/underflow on extreme values.
+func (m *Matrix) normFrobenius() float64 {
+	scale, ssq := 0.0, 1.0
+	add := func(v float64) {
+		if v == 0 {
+			return
+		}
+		av := math.Abs(v)
+		if scale < av {
+			ssq = 1 + ssq*(scale/av)*(scale/av)
+			scale = av
+		} else {
+			ssq += (av / scale) * (av / scale)
+		}
+	}
+	for _, v := range m.d {
+		add(v)
+	}
+	for _, v := range m.dl {
+		add(v)
+	}
+	for _, v := range m.du {
+		add(v)
+	}
+
+	return scale * math.Sqrt(ssq)
+}
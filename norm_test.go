@@ -0,0 +1,119 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tunabay/go-tdma"
+)
+
+func TestMatrix_MulVec(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		1, 2, 1,
+		1, 2, 1,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	y, err := m.MulVec([]float64{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("MulVec failed: %v", err)
+	}
+	want := []float64{4, 8, 12, 11}
+	for i, we := range want {
+		if 1e-9 < abs(y[i]-we) {
+			t.Errorf("got %+v, want %+v", y, want)
+			break
+		}
+	}
+}
+
+func TestMatrix_MulVecTrans(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		3, 2, 1,
+		4, 2, 1,
+		5, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	x := []float64{1, 2, 3, 4}
+	y, err := m.MulVecTrans(x)
+	if err != nil {
+		t.Fatalf("MulVecTrans failed: %v", err)
+	}
+	// A^T*x computed by transposing At(i,j) manually.
+	want := make([]float64, 4)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			want[i] += m.At(j, i) * x[j]
+		}
+	}
+	for i, we := range want {
+		if 1e-9 < abs(y[i]-we) {
+			t.Errorf("got %+v, want %+v", y, want)
+			break
+		}
+	}
+}
+
+func TestMatrix_Norm(t *testing.T) {
+	m, err := tdma.New([]float64{
+		2, 1,
+		3, -4, 1,
+		1, 2, -5,
+		1, 2,
+	})
+	if err != nil {
+		t.Fatalf("invalid test data: %v", err)
+	}
+	// Verify each norm against a brute-force computation over At(i, j)
+	// rather than hand-computed sums.
+	n := 4
+	var maxAbs, frobSq float64
+	colSum := make([]float64, n)
+	rowSum := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v := m.At(i, j)
+			if math.Abs(v) > maxAbs {
+				maxAbs = math.Abs(v)
+			}
+			colSum[j] += math.Abs(v)
+			rowSum[i] += math.Abs(v)
+			frobSq += v * v
+		}
+	}
+	var wantOne, wantInf float64
+	for _, s := range colSum {
+		if s > wantOne {
+			wantOne = s
+		}
+	}
+	for _, s := range rowSum {
+		if s > wantInf {
+			wantInf = s
+		}
+	}
+	wantFrob := math.Sqrt(frobSq)
+
+	if got := m.Norm(tdma.MaxAbs); 1e-9 < math.Abs(got-maxAbs) {
+		t.Errorf("MaxAbs: got %v, want %v", got, maxAbs)
+	}
+	if got := m.Norm(tdma.One); 1e-9 < math.Abs(got-wantOne) {
+		t.Errorf("One: got %v, want %v", got, wantOne)
+	}
+	if got := m.Norm(tdma.Inf); 1e-9 < math.Abs(got-wantInf) {
+		t.Errorf("Inf: got %v, want %v", got, wantInf)
+	}
+	if got := m.Norm(tdma.Frobenius); 1e-9 < math.Abs(got-wantFrob) {
+		t.Errorf("Frobenius: got %v, want %v", got, wantFrob)
+	}
+}
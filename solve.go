@@ -0,0 +1,120 @@
+// Copyright (c) 2022 Hirotsuna Mizuno. All rights reserved.
+// Use of this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package tdma
+
+import "fmt"
+
+// diagonals returns copies of the three diagonals, safe for the in-place
+// factorization routines to mutate.
+func (m *Matrix) diagonals() (dl, d, du []float64) {
+	return append([]float64(nil), m.dl...),
+		append([]float64(nil), m.d...),
+		append([]float64(nil), m.du...)
+}
+
+// factorizePivot performs Gaussian elimination with partial pivoting on the
+// tridiagonal system given by dl, d and du, in place. dl and du are
+// overwritten with the multipliers and the eliminated super-diagonal, d is
+// overwritten with the diagonal of the upper-triangular factor, and du2
+// receives the fill-in second super-diagonal created by row interchanges.
+// ipiv[i] == i means no interchange was made at step i; ipiv[i] == i+1 means
+// rows i and i+1 were swapped.
+func factorizePivot(dl, d, du []float64) (du2 []float64, ipiv []int, err error) {
+	n := len(d)
+	if 2 <= n {
+		du2 = make([]float64, n-2)
+	}
+	ipiv = make([]int, n-1)
+	for i := 0; i < n-1; i++ {
+		if dl[i] == 0 {
+			ipiv[i] = i
+			continue
+		}
+
+		if absF(d[i]) >= absF(dl[i]) {
+			if d[i] == 0 {
+				return nil, nil, fmt.Errorf("%w: zero pivot at row %d", ErrTDMA, i)
+			}
+			factor := dl[i] / d[i]
+			dl[i] = factor
+			d[i+1] -= factor * du[i]
+			ipiv[i] = i
+			continue
+		}
+
+		// |dl[i]| > |d[i]|: swap rows i and i+1.
+		factor := d[i] / dl[i]
+		d[i] = dl[i]
+		dl[i] = factor
+		tmp := du[i]
+		du[i] = d[i+1]
+		d[i+1] = tmp - factor*du[i]
+		if i < n-2 {
+			du2[i] = du[i+1]
+			du[i+1] = -factor * du2[i]
+		}
+		ipiv[i] = i + 1
+	}
+	if d[n-1] == 0 {
+		return nil, nil, fmt.Errorf("%w: zero pivot at row %d", ErrTDMA, n-1)
+	}
+
+	return du2, ipiv, nil
+}
+
+// applyPivot forward-substitutes r against the row operations recorded by
+// factorizePivot, in place.
+func applyPivot(dl []float64, ipiv []int, r []float64) {
+	for i := range ipiv {
+		if ipiv[i] == i {
+			r[i+1] -= dl[i] * r[i]
+			continue
+		}
+		r[i], r[i+1] = r[i+1], r[i]-dl[i]*r[i+1]
+	}
+}
+
+// backSubstitutePivot solves the upper-triangular system given by d, du and
+// du2 against r, returning the solution.
+func backSubstitutePivot(d, du, du2, r []float64) []float64 {
+	n := len(d)
+	x := make([]float64, n)
+	x[n-1] = r[n-1] / d[n-1]
+	if n == 1 {
+		return x
+	}
+	x[n-2] = (r[n-2] - du[n-2]*x[n-1]) / d[n-2]
+	for i := n - 3; 0 <= i; i-- {
+		x[i] = (r[i] - du[i]*x[i+1] - du2[i]*x[i+2]) / d[i]
+	}
+
+	return x
+}
+
+func absF(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// Solve solves the system of equations M * x = r using Gaussian elimination
+// with partial pivoting, and returns the x solved. Unlike TDMA, Solve handles
+// the case where the plain Thomas algorithm hits a zero pivot, as long as the
+// matrix is nonsingular. r must have exactly n elements for the n x n
+// tridiagonal matrix.
+func (m *Matrix) Solve(r []float64) ([]float64, error) {
+	if len(r) != m.n {
+		return nil, fmt.Errorf("%w: r must have exactly %d elements",
+			ErrDimensionMismatch, m.n)
+	}
+	lu, err := m.Factorize()
+	if err != nil {
+		return nil, err
+	}
+
+	return lu.Solve(r)
+}
@@ -16,43 +16,57 @@ var ErrInvalidTridiagonalMatrix = errors.New("invalid tridiagonal matrix")
 // ErrTDMA is the error thrown when TDMA operation is failed.
 var ErrTDMA = errors.New("TDMA failure")
 
+// ErrDimensionMismatch is the error thrown when a vector argument does not
+// have the size required by the matrix it is used with.
+var ErrDimensionMismatch = errors.New("dimension mismatch")
+
 // Matrix represents a tridiagonal matrix.
 // https://en.wikipedia.org/wiki/Tridiagonal_matrix
 //
-//     (n=4)
-//     +-                   -+
-//     | e[0] e[1]         0 |
-//     | e[2] e[3] e[4]      |
-//     |      e[5] e[6] e[7] |
-//     |    0      e[8] e[9] |
-//     +-                   -+
+//	(n=4)
+//	+-                   -+
+//	| e[0] e[1]         0 |
+//	| e[2] e[3] e[4]      |
+//	|      e[5] e[6] e[7] |
+//	|    0      e[8] e[9] |
+//	+-                   -+
+//
+//	(4<=n)
+//	+-                                         -+
+//	| e[ 0] e[ 1]                             0 |
+//	| e[ 2] e[ 3] e[ 4]                         |
+//	|                 ...                       |
+//	|                   e[3n-7] e[3n-6] e[3n-5] |
+//	|     0                     e[3n-4] e[3n-3] |
+//	+-                                         -+
 //
-//     (4<=n)
-//     +-                                         -+
-//     | e[ 0] e[ 1]                             0 |
-//     | e[ 2] e[ 3] e[ 4]                         |
-//     |                 ...                       |
-//     |                   e[3n-7] e[3n-6] e[3n-5] |
-//     |     0                     e[3n-4] e[3n-3] |
-//     +-                                         -+
+// Internally, a Matrix is held as three diagonals: dl (sub-diagonal, length
+// n-1), d (main diagonal, length n) and du (super-diagonal, length n-1). See
+// NewFromDiagonals and Diagonals to work with this form directly.
 type Matrix struct {
-	n int       // n x n tridiagonal matrix
-	m []float64 // (3n - 2) elements
+	n         int // n x n tridiagonal matrix
+	dl, d, du []float64
 }
 
-// New creates a new tridiagonal matrix from the slice of elements d.
-// The size of d must be exactly (3n - 2) for the n x n matrix.
-func New(d []float64) (*Matrix, error) {
-	if len(d)%3 != 1 {
+// New creates a new tridiagonal matrix from the slice of elements e, packed
+// row by row. The size of e must be exactly (3n - 2) for the n x n matrix.
+func New(e []float64) (*Matrix, error) {
+	if len(e)%3 != 1 {
 		return nil, fmt.Errorf("%w: length %d must 3n-2",
-			ErrInvalidTridiagonalMatrix, len(d))
+			ErrInvalidTridiagonalMatrix, len(e))
 	}
-	m := &Matrix{
-		n: (len(d)-1)/3 + 1,
-		m: d,
+	n := (len(e)-1)/3 + 1
+	d := make([]float64, n)
+	dl := make([]float64, n-1)
+	du := make([]float64, n-1)
+	d[0] = e[0]
+	for i := 0; i < n-1; i++ {
+		du[i] = e[i*3+1]
+		dl[i] = e[i*3+2]
+		d[i+1] = e[i*3+3]
 	}
 
-	return m, nil
+	return &Matrix{n: n, dl: dl, d: d, du: du}, nil
 }
 
 // TDMA solves the system of equations M * x = r using TDMA (tridiagonal matrix
@@ -62,53 +76,36 @@ func New(d []float64) (*Matrix, error) {
 func (m *Matrix) TDMA(r []float64) ([]float64, error) {
 	if len(r) != m.n {
 		return nil, fmt.Errorf("%w: r must have exactly %d elements",
-			ErrTDMA, m.n)
+			ErrDimensionMismatch, m.n)
 	}
 	c := make([]float64, m.n-1)
-	if m.m[0] == 0 {
-		return nil, fmt.Errorf("%w: m[0] is zero", ErrTDMA)
+	if m.d[0] == 0 {
+		return nil, fmt.Errorf("%w: d[0] is zero", ErrTDMA)
 	}
-	c[0] = m.m[1] / m.m[0]
+	c[0] = m.du[0] / m.d[0]
 	for i := 1; i < m.n-1; i++ {
-		i3 := i * 3
-		if m.m[i3] == m.m[i3-1]*c[i-1] {
-			// TODO: find out how to solve this case
-			return nil, fmt.Errorf("%w: m[%d] == m[%d]c[%d] == %f",
-				ErrTDMA, i3, i3-1, i-1, m.m[i3])
+		if m.d[i] == m.dl[i-1]*c[i-1] {
+			// TODO: find out how to solve this case; use Solve instead.
+			return nil, fmt.Errorf("%w: d[%d] == dl[%d]*c[%d] == %f",
+				ErrTDMA, i, i-1, i-1, m.d[i])
 		}
-		c[i] = m.m[i3+1] / (m.m[i3] - m.m[i3-1]*c[i-1])
+		c[i] = m.du[i] / (m.d[i] - m.dl[i-1]*c[i-1])
 	}
-	if i, i3 := m.n-1, (m.n-1)*3; m.m[i3] == m.m[i3-1]*c[i-1] {
-		// TODO: find out how to solve this case
-		return nil, fmt.Errorf("%w: m[%d] == m[%d]c[%d] == %f",
-			ErrTDMA, i3, i3-1, i-1, m.m[i3])
+	if i := m.n - 1; m.d[i] == m.dl[i-1]*c[i-1] {
+		// TODO: find out how to solve this case; use Solve instead.
+		return nil, fmt.Errorf("%w: d[%d] == dl[%d]*c[%d] == %f",
+			ErrTDMA, i, i-1, i-1, m.d[i])
 	}
-	d := make([]float64, m.n)
-	d[0] = r[0] / m.m[0]
+	dd := make([]float64, m.n)
+	dd[0] = r[0] / m.d[0]
 	for i := 1; i < m.n; i++ {
-		i3 := i * 3
-		d[i] = (r[i] - m.m[i3-1]*d[i-1]) / (m.m[i3] - m.m[i3-1]*c[i-1])
+		dd[i] = (r[i] - m.dl[i-1]*dd[i-1]) / (m.d[i] - m.dl[i-1]*c[i-1])
 	}
 	x := make([]float64, m.n)
-	x[m.n-1] = d[m.n-1]
+	x[m.n-1] = dd[m.n-1]
 	for i := m.n - 2; 0 <= i; i-- {
-		x[i] = d[i] - c[i]*x[i+1]
+		x[i] = dd[i] - c[i]*x[i+1]
 	}
 
 	return x, nil
 }
-
-//
-func detF(elem []float64, n int) float64 {
-	switch n {
-	case 0:
-		return elem[0]
-	case 1:
-		return elem[3]*elem[0] - elem[2]*elem[1]
-	}
-	idx := n * 3
-	return elem[idx]*detF(elem, n-1) - elem[idx-1]*elem[idx-2]*detF(elem, n-2)
-}
-
-// Determinant calculates the determinant of the tridiagonal matrix.
-func (m *Matrix) Determinant() float64 { return detF(m.m, m.n-1) }
@@ -84,25 +84,8 @@ func TestMatrix_TDMA_1(t *testing.T) {
 		{1, 2, 6, 34, 10, 1, 4, 22, 25, 3},
 		{1, -1, 2, 1, 3, -2, 0, 4, 2, -1},
 
-		// #6 fail
-		// TODO: find out how to solve this
-		// https://www.scirp.org/pdf/AM_2014021111074341.pdf
-		/*
-			{
-				1, 1,
-				1, 1, 10,
-				7, 1, 2,
-				2, 11, 1,
-				2, 3, 7,
-				3, 1, 2,
-				-1, 2, 2,
-				2, 1, 1,
-				5, 2, 4,
-				1, 5,
-			},
-			{4, 14, 26, 25, 0, 2, 1, 3, 10, 8},
-			{1, 3, 1, 2, 1, -1, 0, 0, 3, 1},
-		*/
+		// A case that hits a zero pivot with the plain Thomas algorithm is
+		// tested in TestMatrix_Solve (#1), which uses partial pivoting.
 	}
 	for i := 0; i < len(tdata); i += 3 {
 		tno := i/3 + 1
@@ -122,3 +105,64 @@ func TestMatrix_TDMA_1(t *testing.T) {
 		t.Logf("#%d: passed: %+v", tno, x)
 	}
 }
+
+func TestMatrix_Solve(t *testing.T) {
+	equal := func(x, y []float64) bool {
+		const eps = 1e-3
+		if len(x) != len(y) {
+			return false
+		}
+		for i, xe := range x {
+			if eps < math.Abs(y[i]-xe) {
+				return false
+			}
+		}
+		return true
+	}
+	tdata := [][]float64{
+		// #1: a case that fails with the plain Thomas algorithm because it
+		// hits a zero pivot, but is solvable with partial pivoting.
+		// https://www.scirp.org/pdf/AM_2014021111074341.pdf
+		{
+			1, 1,
+			1, 1, 10,
+			7, 1, 2,
+			2, 11, 1,
+			2, 3, 7,
+			3, 1, 2,
+			-1, 2, 2,
+			2, 1, 1,
+			5, 2, 4,
+			1, 5,
+		},
+		{4, 14, 26, 25, 0, 2, 1, 3, 10, 8},
+		{1, 3, 1, 2, 1, -1, 0, 0, 3, 1},
+
+		// #2: a regular diagonally dominant case should still work.
+		{
+			2, 1,
+			1, 2, 1,
+			1, 2, 1,
+			1, 2,
+		},
+		{4, 8, 12, 11},
+		{1, 2, 3, 4},
+	}
+	for i := 0; i < len(tdata); i += 3 {
+		tno := i/3 + 1
+		m, err := tdma.New(tdata[i])
+		if err != nil {
+			t.Fatalf("#%d: invalid test data: %v", tno, err)
+		}
+		x, err := m.Solve(tdata[i+1])
+		if err != nil {
+			t.Errorf("#%d: Solve failed: %v", tno, err)
+			continue
+		}
+		if !equal(x, tdata[i+2]) {
+			t.Errorf("#%d: got %+v, want %+v", tno, x, tdata[i+2])
+			continue
+		}
+		t.Logf("#%d: passed: %+v", tno, x)
+	}
+}